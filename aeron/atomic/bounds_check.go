@@ -0,0 +1,35 @@
+//go:build !aeron_unsafe
+// +build !aeron_unsafe
+
+/*
+Copyright 2016 Stanislav Liberman
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package atomic
+
+// BoundsCheck panics with a BufferError when index+length would read or write past
+// the end of the buffer. Build with the aeron_unsafe tag to compile this check out
+// of the hot path.
+func (buf *Buffer) BoundsCheck(index int32, length int32) {
+	if (index + length) > buf.length {
+		panic(BufferError{Index: index, Length: length, Capacity: buf.length})
+	}
+}
+
+func boundsCheck(index int32, length int32, myLength int32) {
+	if (index + length) > myLength {
+		panic(BufferError{Index: index, Length: length, Capacity: myLength})
+	}
+}
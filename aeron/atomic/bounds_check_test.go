@@ -0,0 +1,45 @@
+/*
+Copyright 2016 Stanislav Liberman
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package atomic
+
+import (
+	"testing"
+)
+
+func TestBoundsCheckPanicsWithBufferError(t *testing.T) {
+	buf := MakeBufferFromSlice(make([]byte, 4))
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatal("expected a panic")
+		}
+		if _, ok := r.(BufferError); !ok {
+			t.Fatalf("expected BufferError, got %T", r)
+		}
+	}()
+
+	buf.GetInt64(0)
+}
+
+func TestBoundsCheckWithinCapacityDoesNotPanic(t *testing.T) {
+	buf := MakeBufferFromSlice(make([]byte, 4))
+	buf.PutInt32(0, 42)
+	if buf.GetInt32(0) != 42 {
+		t.Fatal("in-bounds access should not panic")
+	}
+}
@@ -0,0 +1,27 @@
+//go:build aeron_unsafe
+// +build aeron_unsafe
+
+/*
+Copyright 2016 Stanislav Liberman
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package atomic
+
+// BoundsCheck is a no-op under the aeron_unsafe build tag.
+func (buf *Buffer) BoundsCheck(index int32, length int32) {
+}
+
+func boundsCheck(index int32, length int32, myLength int32) {
+}
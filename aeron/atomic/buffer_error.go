@@ -0,0 +1,31 @@
+/*
+Copyright 2016 Stanislav Liberman
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package atomic
+
+import "fmt"
+
+// BufferError reports an access that would read or write past the end of a Buffer.
+// It is the value BoundsCheck panics with.
+type BufferError struct {
+	Index    int32
+	Length   int32
+	Capacity int32
+}
+
+func (e BufferError) Error() string {
+	return fmt.Sprintf("index out of bounds. index: %d + %d capacity: %d", e.Index, e.Length, e.Capacity)
+}
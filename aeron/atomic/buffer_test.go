@@ -0,0 +1,80 @@
+/*
+Copyright 2016 Stanislav Liberman
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package atomic
+
+import (
+	"testing"
+)
+
+func TestMakeBufferFromSlice(t *testing.T) {
+	bytes := make([]byte, 16)
+	buf := MakeBufferFromSlice(bytes)
+	if buf.Capacity() != 16 {
+		t.Fatalf("expected capacity 16, got %d", buf.Capacity())
+	}
+	buf.PutInt32(0, 42)
+	if buf.GetInt32(0) != 42 {
+		t.Fatal("round trip through wrapped slice failed")
+	}
+}
+
+func TestMakeBufferFromSliceRange(t *testing.T) {
+	bytes := make([]byte, 16)
+	buf := MakeBufferFromSliceRange(bytes, 4, 8)
+	if buf.Capacity() != 8 {
+		t.Fatalf("expected capacity 8, got %d", buf.Capacity())
+	}
+	buf.PutInt32(0, 7)
+	if bytes[4] == 0 && bytes[5] == 0 && bytes[6] == 0 && bytes[7] == 0 {
+		t.Fatal("expected write to land at the byte offset within the underlying slice")
+	}
+}
+
+func TestMakeBufferFromPtr(t *testing.T) {
+	bytes := make([]byte, 16)
+	buf := MakeBufferFromSlice(bytes)
+	same := MakeBufferFromPtr(buf.Ptr(), buf.Capacity())
+	same.PutInt32(0, 99)
+	if buf.GetInt32(0) != 99 {
+		t.Fatal("expected MakeBufferFromPtr to wrap the same memory")
+	}
+}
+
+func TestMakeBufferFromPtrRange(t *testing.T) {
+	bytes := make([]byte, 16)
+	whole := MakeBufferFromSlice(bytes)
+	buf := MakeBufferFromPtrRange(whole.Ptr(), 4, 8)
+	if buf.Capacity() != 8 {
+		t.Fatalf("expected capacity 8, got %d", buf.Capacity())
+	}
+	buf.PutInt32(0, 7)
+	if bytes[4] == 0 && bytes[5] == 0 && bytes[6] == 0 && bytes[7] == 0 {
+		t.Fatal("expected write to land at the byte offset within the underlying memory")
+	}
+}
+
+func TestMakeBufferThreeArgShim(t *testing.T) {
+	bytes := make([]byte, 16)
+	buf := MakeBuffer(bytes, 4, 8)
+	if buf.Capacity() != 8 {
+		t.Fatalf("expected capacity 8, got %d", buf.Capacity())
+	}
+	buf.PutInt32(0, 1)
+	if bytes[4] == 0 && bytes[5] == 0 && bytes[6] == 0 && bytes[7] == 0 {
+		t.Fatal("expected 3-arg MakeBuffer to offset into the underlying slice")
+	}
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2016 Stanislav Liberman
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package atomic
+
+import (
+	"testing"
+)
+
+func TestLittleAndBigEndianAccessorsRoundTrip(t *testing.T) {
+	buf := MakeBufferFromSlice(make([]byte, 16))
+
+	buf.PutInt32LE(0, 0x01020304)
+	if buf.GetInt32LE(0) != 0x01020304 {
+		t.Fatal("LE round trip failed")
+	}
+	if buf.GetInt32BE(0) != 0x04030201 {
+		t.Fatalf("expected BE read of an LE write to be byte-reversed, got %x", buf.GetInt32BE(0))
+	}
+
+	buf.PutInt64BE(8, 0x0102030405060708)
+	if buf.GetInt64BE(8) != 0x0102030405060708 {
+		t.Fatal("BE round trip failed")
+	}
+	if buf.GetInt64LE(8) != 0x0807060504030201 {
+		t.Fatalf("expected LE read of a BE write to be byte-reversed, got %x", buf.GetInt64LE(8))
+	}
+}
+
+func TestUInt16EndianAccessorsRoundTrip(t *testing.T) {
+	buf := MakeBufferFromSlice(make([]byte, 4))
+
+	buf.PutUInt16LE(0, 0x0102)
+	if buf.GetUInt16LE(0) != 0x0102 {
+		t.Fatal("LE round trip failed")
+	}
+	if buf.GetUInt16BE(0) != 0x0201 {
+		t.Fatalf("expected BE read of an LE write to be byte-reversed, got %x", buf.GetUInt16BE(0))
+	}
+}
@@ -104,11 +104,37 @@ func (image *Image) IsClosed() bool {
 	return image.isClosed.Get()
 }
 
-func (image *Image) Poll(handler term.FragmentHandler, fragmentLimit int) int {
+// SetExceptionHandler registers a callback invoked when Poll, ControlledPoll or
+// BoundedPoll recover a buffer bounds violation.
+func (image *Image) SetExceptionHandler(handler func(error)) *Image {
+	image.exceptionHandler = handler
+	return image
+}
 
-	result := ImageClosed
+// recoverBufferError is deferred by Poll, ControlledPoll and BoundedPoll to turn a
+// recovered atomic.BufferError into a returned error instead of a bare panic.
+func (image *Image) recoverBufferError(err *error) {
+	if r := recover(); r != nil {
+		bufErr, ok := r.(atomic.BufferError)
+		if !ok {
+			panic(r)
+		}
+
+		if image.exceptionHandler != nil {
+			image.exceptionHandler(bufErr)
+		}
+
+		*err = bufErr
+	}
+}
+
+func (image *Image) Poll(handler term.FragmentHandler, fragmentLimit int) (result int, err error) {
+
+	result = ImageClosed
 
 	if !image.IsClosed() {
+		defer image.recoverBufferError(&err)
+
 		position := image.subscriberPosition.get()
 		termOffset := int32(position) & image.termLengthMask
 		index := indexByPosition(position, image.positionBitsToShift)
@@ -123,7 +149,124 @@ func (image *Image) Poll(handler term.FragmentHandler, fragmentLimit int) int {
 		}
 	}
 
-	return result
+	return result, err
+}
+
+// ControlledPoll is like Poll, but handler returns a ControlledPollAction that decides
+// how the subscriber position advances: ABORT, BREAK, COMMIT or CONTINUE.
+func (image *Image) ControlledPoll(handler ControlledPollFragmentHandler, fragmentLimit int) (fragmentsRead int, err error) {
+
+	fragmentsRead = ImageClosed
+
+	if !image.IsClosed() {
+		defer image.recoverBufferError(&err)
+
+		fragmentsRead = 0
+		initialPosition := image.subscriberPosition.get()
+		initialOffset := int32(initialPosition) & image.termLengthMask
+		offset := initialOffset
+
+		index := indexByPosition(initialPosition, image.positionBitsToShift)
+		termBuffer := image.termBuffers[index]
+		capacity := termBuffer.Capacity()
+
+		image.header.SetBuffer(termBuffer)
+
+		for fragmentsRead < fragmentLimit && offset < capacity {
+			frameLength := term.FrameLengthVolatile(termBuffer, offset)
+			if frameLength <= 0 {
+				break
+			}
+
+			frameOffset := offset
+			alignedLength := util.AlignInt32(frameLength, logbuffer.FrameDescriptor.FrameAlignment)
+			offset += alignedLength
+
+			if !term.IsPaddingFrame(termBuffer, frameOffset) {
+				image.header.SetOffset(frameOffset)
+
+				action := handler(termBuffer, frameOffset+logbuffer.FrameDescriptor.HeaderLength,
+					frameLength-logbuffer.FrameDescriptor.HeaderLength, &image.header)
+				fragmentsRead++
+
+				if action == ControlledPollAction.ABORT {
+					fragmentsRead--
+					offset -= alignedLength
+					break
+				}
+
+				if action == ControlledPollAction.BREAK {
+					break
+				} else if action == ControlledPollAction.COMMIT {
+					initialPosition += int64(offset - initialOffset)
+					initialOffset = offset
+					image.subscriberPosition.set(initialPosition)
+				}
+			}
+		}
+
+		resultingPosition := initialPosition + int64(offset-initialOffset)
+		if resultingPosition > initialPosition {
+			image.subscriberPosition.set(resultingPosition)
+		}
+	}
+
+	return fragmentsRead, err
+}
+
+// BoundedPoll is like Poll but stops consuming fragments once the term offset would
+// advance the position past limitPosition.
+func (image *Image) BoundedPoll(handler term.FragmentHandler, limitPosition int64, fragmentLimit int) (fragmentsRead int, err error) {
+
+	fragmentsRead = ImageClosed
+
+	if !image.IsClosed() {
+		defer image.recoverBufferError(&err)
+
+		fragmentsRead = 0
+		initialPosition := image.subscriberPosition.get()
+		initialOffset := int32(initialPosition) & image.termLengthMask
+		offset := initialOffset
+
+		index := indexByPosition(initialPosition, image.positionBitsToShift)
+		termBuffer := image.termBuffers[index]
+		endOffset := termBuffer.Capacity()
+		if limitPosition-initialPosition < int64(endOffset-offset) {
+			endOffset = offset + int32(limitPosition-initialPosition)
+		}
+
+		image.header.SetBuffer(termBuffer)
+
+		for fragmentsRead < fragmentLimit && offset < endOffset {
+			frameLength := term.FrameLengthVolatile(termBuffer, offset)
+			if frameLength <= 0 {
+				break
+			}
+
+			frameOffset := offset
+			alignedLength := util.AlignInt32(frameLength, logbuffer.FrameDescriptor.FrameAlignment)
+			if frameOffset+alignedLength > endOffset {
+				break
+			}
+			offset += alignedLength
+
+			if !term.IsPaddingFrame(termBuffer, frameOffset) {
+				image.header.SetOffset(frameOffset)
+
+				handler(termBuffer, frameOffset+logbuffer.FrameDescriptor.HeaderLength,
+					frameLength-logbuffer.FrameDescriptor.HeaderLength, &image.header)
+
+				fragmentsRead++
+			}
+		}
+
+		resultingPosition := initialPosition + int64(offset-initialOffset)
+		if resultingPosition > initialPosition {
+			image.subscriberPosition.set(resultingPosition)
+		}
+	}
+
+	return fragmentsRead, err
 }
 
 // Close the image and mappings. The image becomes unusable after closing.
@@ -0,0 +1,63 @@
+/*
+Copyright 2016 Stanislav Liberman
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aeron
+
+import (
+	"testing"
+)
+
+func newClosedTestImage() *Image {
+	image := new(Image)
+	image.isClosed.Set(true)
+	return image
+}
+
+func TestPollOnClosedImageReturnsImageClosed(t *testing.T) {
+	image := newClosedTestImage()
+
+	result, err := image.Poll(nil, 10)
+	if result != ImageClosed {
+		t.Fatalf("expected ImageClosed, got %d", result)
+	}
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+}
+
+func TestControlledPollOnClosedImageReturnsImageClosed(t *testing.T) {
+	image := newClosedTestImage()
+
+	fragmentsRead, err := image.ControlledPoll(nil, 10)
+	if fragmentsRead != ImageClosed {
+		t.Fatalf("expected ImageClosed, got %d", fragmentsRead)
+	}
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+}
+
+func TestBoundedPollOnClosedImageReturnsImageClosed(t *testing.T) {
+	image := newClosedTestImage()
+
+	fragmentsRead, err := image.BoundedPoll(nil, 0, 10)
+	if fragmentsRead != ImageClosed {
+		t.Fatalf("expected ImageClosed, got %d", fragmentsRead)
+	}
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+}
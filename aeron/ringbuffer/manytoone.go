@@ -173,7 +173,42 @@ func (buf *ManyToOne) Write(msgTypeID int32, srcBuffer *atomic.Buffer, srcIndex
 	return isSuccessful
 }
 
-func (buf *ManyToOne) Read(Handler, messageCountLimit int) int32 {
-	panic("Not implemented yet")
-	return -1
+func (buf *ManyToOne) Read(handler Handler, messageCountLimit int) int32 {
+	messagesRead := int32(0)
+
+	head := buf.buffer.GetInt64Volatile(buf.headPositionIndex)
+
+	bytesRead := int32(0)
+
+	mask := buf.capacity - 1
+	headIndex := int32(head) & mask
+	contiguousBlockLength := buf.capacity - headIndex
+
+	defer func() {
+		if bytesRead != 0 {
+			buf.buffer.SetMemory(headIndex, bytesRead, 0)
+			buf.buffer.PutInt64Ordered(buf.headPositionIndex, head+int64(bytesRead))
+		}
+	}()
+
+	for bytesRead < contiguousBlockLength && messagesRead < int32(messageCountLimit) {
+		recordIndex := headIndex + bytesRead
+		recordLength := buf.buffer.GetInt32Volatile(LengthOffset(recordIndex))
+
+		if recordLength <= 0 {
+			break
+		}
+
+		bytesRead += util.AlignInt32(recordLength, RecordDescriptor.RecordAlignment)
+
+		msgTypeID := buf.buffer.GetInt32(TypeOffset(recordIndex))
+		if RecordDescriptor.PaddingMsgTypeID == msgTypeID {
+			continue
+		}
+
+		messagesRead++
+		handler(buf.buffer, EncodedMsgOffset(recordIndex), recordLength-RecordDescriptor.HeaderLength)
+	}
+
+	return messagesRead
 }
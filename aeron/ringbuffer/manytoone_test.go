@@ -0,0 +1,111 @@
+/*
+Copyright 2016 Stanislav Liberman
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rb
+
+import (
+	"github.com/lirm/aeron-go/aeron/atomic"
+	"testing"
+)
+
+var manyToOneTestCapacity = 1024 + int32(Descriptor.trailerLength)
+
+func newManyToOneTestBuffer() *ManyToOne {
+	buf := new(ManyToOne)
+	return buf.Init(atomic.MakeBufferFromSlice(make([]byte, manyToOneTestCapacity)))
+}
+
+func TestManyToOneWriteRead(t *testing.T) {
+	buf := newManyToOneTestBuffer()
+	msg := []byte("hello")
+	src := atomic.MakeBufferFromSlice(msg)
+
+	if !buf.Write(1, src, 0, int32(len(msg))) {
+		t.Fatal("Write failed")
+	}
+
+	var got []byte
+	messagesRead := buf.Read(func(b *atomic.Buffer, offset int32, length int32) {
+		got = b.GetBytesArray(offset, length)
+	}, 10)
+
+	if messagesRead != 1 {
+		t.Fatalf("expected 1 message read, got %d", messagesRead)
+	}
+	if string(got) != string(msg) {
+		t.Fatalf("expected %q, got %q", msg, got)
+	}
+}
+
+func TestManyToOneReadRespectsMessageCountLimit(t *testing.T) {
+	buf := newManyToOneTestBuffer()
+	msg := []byte("x")
+	src := atomic.MakeBufferFromSlice(msg)
+
+	for i := 0; i < 3; i++ {
+		if !buf.Write(1, src, 0, int32(len(msg))) {
+			t.Fatal("Write failed")
+		}
+	}
+
+	messagesRead := buf.Read(func(b *atomic.Buffer, offset int32, length int32) {}, 2)
+	if messagesRead != 2 {
+		t.Fatalf("expected 2 messages read, got %d", messagesRead)
+	}
+
+	messagesRead = buf.Read(func(b *atomic.Buffer, offset int32, length int32) {}, 10)
+	if messagesRead != 1 {
+		t.Fatalf("expected 1 remaining message, got %d", messagesRead)
+	}
+}
+
+func TestManyToOneWriteInsufficientCapacity(t *testing.T) {
+	buf := newManyToOneTestBuffer()
+	msg := make([]byte, buf.maxMsgLength)
+	src := atomic.MakeBufferFromSlice(msg)
+
+	for buf.Write(1, src, 0, int32(len(msg))) {
+	}
+	if buf.Write(1, src, 0, int32(len(msg))) {
+		t.Fatal("expected Write to fail once capacity is exhausted")
+	}
+}
+
+func TestManyToOneReadSkipsPaddingAtBufferEnd(t *testing.T) {
+	buf := newManyToOneTestBuffer()
+
+	// Nine 112-byte records leave 16 bytes before the end; once they are drained, the
+	// tenth record is placed at index 0 behind a 16-byte padding record.
+	msg := make([]byte, 112-RecordDescriptor.HeaderLength)
+	src := atomic.MakeBufferFromSlice(msg)
+	for i := 0; i < 9; i++ {
+		if !buf.Write(1, src, 0, int32(len(msg))) {
+			t.Fatal("Write failed")
+		}
+	}
+	buf.Read(func(b *atomic.Buffer, offset int32, length int32) {}, 10)
+	if !buf.Write(1, src, 0, int32(len(msg))) {
+		t.Fatal("Write failed")
+	}
+
+	var messagesRead int32
+	for messagesRead == 0 {
+		messagesRead += buf.Read(func(b *atomic.Buffer, offset int32, length int32) {}, 10)
+	}
+	if messagesRead != 1 {
+		t.Fatalf("expected 1 message read, got %d", messagesRead)
+	}
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2016 Stanislav Liberman
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rb
+
+import (
+	"fmt"
+	"github.com/lirm/aeron-go/aeron/atomic"
+	"github.com/lirm/aeron-go/aeron/util"
+)
+
+// OneToOne is a ring buffer for a single producer, single consumer pair.
+type OneToOne struct {
+	buffer                    *atomic.Buffer
+	capacity                  int32
+	maxMsgLength              int32
+	headPositionIndex         int32
+	headCachePositionIndex    int32
+	tailPositionIndex         int32
+	correlationIDCounterIndex int32
+	consumerHeartbeatIndex    int32
+}
+
+func (buf *OneToOne) Init(buffer *atomic.Buffer) *OneToOne {
+
+	buf.buffer = buffer
+	buf.capacity = buffer.Capacity() - Descriptor.trailerLength
+
+	util.IsPowerOfTwo(buf.capacity)
+
+	buf.maxMsgLength = buf.capacity / 8
+	buf.tailPositionIndex = buf.capacity + Descriptor.tailPositionOffset
+	buf.headCachePositionIndex = buf.capacity + Descriptor.headCachePositionOffset
+	buf.headPositionIndex = buf.capacity + Descriptor.headPositionOffset
+	buf.correlationIDCounterIndex = buf.capacity + Descriptor.correlationCounterOffset
+	buf.consumerHeartbeatIndex = buf.capacity + Descriptor.consumerHeartbeatOffset
+
+	return buf
+}
+
+func (buf *OneToOne) NextCorrelationID() int64 {
+	return buf.buffer.GetAndAddInt64(buf.correlationIDCounterIndex, 1)
+}
+
+func (buf *OneToOne) SetConsumerHeartbeatTime(time int64) {
+	buf.buffer.PutInt64Ordered(buf.consumerHeartbeatIndex, time)
+}
+
+func (buf *OneToOne) ConsumerHeartbeatTime() int64 {
+	return buf.buffer.GetInt64Volatile(buf.consumerHeartbeatIndex)
+}
+
+func (buf *OneToOne) ProducerPosition() int64 {
+	return buf.buffer.GetInt64Volatile(buf.tailPositionIndex)
+}
+
+func (buf *OneToOne) ConsumerPosition() int64 {
+	return buf.buffer.GetInt64Volatile(buf.headPositionIndex)
+}
+
+func (buf *OneToOne) Capacity() int32 {
+	return buf.capacity
+}
+
+// claimCapacity advances the tail with a plain read and ordered store, not a CAS loop.
+func (buf *OneToOne) claimCapacity(requiredCapacity int32) int32 {
+
+	mask := buf.capacity - 1
+	head := buf.buffer.GetInt64Volatile(buf.headCachePositionIndex)
+	tail := buf.buffer.GetInt64(buf.tailPositionIndex)
+
+	availableCapacity := buf.capacity - int32(tail-head)
+
+	var padding int32
+
+	if requiredCapacity > availableCapacity {
+		head = buf.buffer.GetInt64Volatile(buf.headPositionIndex)
+
+		if requiredCapacity > (buf.capacity - int32(tail-head)) {
+			return InsufficientCapacity
+		}
+
+		buf.buffer.PutInt64Ordered(buf.headCachePositionIndex, head)
+	}
+
+	tailIndex := int32(tail & int64(mask))
+	toBufferEndLength := buf.capacity - tailIndex
+
+	if requiredCapacity > toBufferEndLength {
+		headIndex := int32(head & int64(mask))
+
+		if requiredCapacity > headIndex {
+			head = buf.buffer.GetInt64Volatile(buf.headPositionIndex)
+			headIndex = int32(head & int64(mask))
+
+			if requiredCapacity > headIndex {
+				return InsufficientCapacity
+			}
+
+			buf.buffer.PutInt64Ordered(buf.headCachePositionIndex, head)
+		}
+
+		padding = toBufferEndLength
+	}
+
+	if 0 != padding {
+		buf.buffer.PutInt64Ordered(tailIndex, MakeHeader(int32(padding), RecordDescriptor.PaddingMsgTypeID))
+		tailIndex = 0
+	}
+
+	buf.buffer.PutInt64Ordered(buf.tailPositionIndex, tail+int64(requiredCapacity)+int64(padding))
+
+	return tailIndex
+}
+
+func (buf *OneToOne) checkMsgLength(length int32) {
+	if length > buf.maxMsgLength {
+		panic(fmt.Sprintf("encoded message exceeds maxMsgLength of %d, length=%d", buf.maxMsgLength, length))
+	}
+}
+
+func (buf *OneToOne) Write(msgTypeID int32, srcBuffer *atomic.Buffer, srcIndex int32, length int32) bool {
+
+	isSuccessful := false
+
+	CheckMsgTypeID(msgTypeID)
+	buf.checkMsgLength(length)
+
+	recordLength := length + RecordDescriptor.HeaderLength
+	requiredCapacity := util.AlignInt32(recordLength, RecordDescriptor.RecordAlignment)
+	recordIndex := buf.claimCapacity(requiredCapacity)
+
+	if InsufficientCapacity != recordIndex {
+		buf.buffer.PutInt64Ordered(recordIndex, MakeHeader(-recordLength, msgTypeID))
+		buf.buffer.PutBytes(EncodedMsgOffset(recordIndex), srcBuffer, srcIndex, length)
+		buf.buffer.PutInt32Ordered(LengthOffset(recordIndex), recordLength)
+
+		isSuccessful = true
+	}
+
+	return isSuccessful
+}
+
+func (buf *OneToOne) Read(handler Handler, messageCountLimit int) int32 {
+	messagesRead := int32(0)
+
+	head := buf.buffer.GetInt64Volatile(buf.headPositionIndex)
+
+	bytesRead := int32(0)
+
+	mask := buf.capacity - 1
+	headIndex := int32(head) & mask
+	contiguousBlockLength := buf.capacity - headIndex
+
+	defer func() {
+		if bytesRead != 0 {
+			buf.buffer.SetMemory(headIndex, bytesRead, 0)
+			buf.buffer.PutInt64Ordered(buf.headPositionIndex, head+int64(bytesRead))
+		}
+	}()
+
+	for bytesRead < contiguousBlockLength && messagesRead < int32(messageCountLimit) {
+		recordIndex := headIndex + bytesRead
+		recordLength := buf.buffer.GetInt32Volatile(LengthOffset(recordIndex))
+
+		if recordLength <= 0 {
+			break
+		}
+
+		bytesRead += util.AlignInt32(recordLength, RecordDescriptor.RecordAlignment)
+
+		msgTypeID := buf.buffer.GetInt32(TypeOffset(recordIndex))
+		if RecordDescriptor.PaddingMsgTypeID == msgTypeID {
+			continue
+		}
+
+		messagesRead++
+		handler(buf.buffer, EncodedMsgOffset(recordIndex), recordLength-RecordDescriptor.HeaderLength)
+	}
+
+	return messagesRead
+}
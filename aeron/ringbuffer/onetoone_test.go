@@ -0,0 +1,79 @@
+/*
+Copyright 2016 Stanislav Liberman
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rb
+
+import (
+	"github.com/lirm/aeron-go/aeron/atomic"
+	"testing"
+)
+
+func newOneToOneTestBuffer() *OneToOne {
+	buf := new(OneToOne)
+	return buf.Init(atomic.MakeBufferFromSlice(make([]byte, manyToOneTestCapacity)))
+}
+
+func TestOneToOneWriteRead(t *testing.T) {
+	buf := newOneToOneTestBuffer()
+	msg := []byte("hello")
+	src := atomic.MakeBufferFromSlice(msg)
+
+	if !buf.Write(1, src, 0, int32(len(msg))) {
+		t.Fatal("Write failed")
+	}
+
+	var got []byte
+	messagesRead := buf.Read(func(b *atomic.Buffer, offset int32, length int32) {
+		got = b.GetBytesArray(offset, length)
+	}, 10)
+
+	if messagesRead != 1 {
+		t.Fatalf("expected 1 message read, got %d", messagesRead)
+	}
+	if string(got) != string(msg) {
+		t.Fatalf("expected %q, got %q", msg, got)
+	}
+}
+
+func TestOneToOneWrapsAroundAfterDraining(t *testing.T) {
+	buf := newOneToOneTestBuffer()
+	// Records of capacity/8 bytes fill the buffer exactly, so the ninth write wraps to
+	// index 0 without a padding record.
+	msg := make([]byte, buf.capacity/8-RecordDescriptor.HeaderLength)
+	src := atomic.MakeBufferFromSlice(msg)
+
+	for i := 0; i < 10; i++ {
+		if !buf.Write(1, src, 0, int32(len(msg))) {
+			t.Fatal("Write failed")
+		}
+		messagesRead := buf.Read(func(b *atomic.Buffer, offset int32, length int32) {}, 10)
+		if messagesRead != 1 {
+			t.Fatalf("iteration %d: expected 1 message read, got %d", i, messagesRead)
+		}
+	}
+}
+
+func TestOneToOneWriteInsufficientCapacity(t *testing.T) {
+	buf := newOneToOneTestBuffer()
+	msg := make([]byte, buf.maxMsgLength)
+	src := atomic.MakeBufferFromSlice(msg)
+
+	for buf.Write(1, src, 0, int32(len(msg))) {
+	}
+	if buf.Write(1, src, 0, int32(len(msg))) {
+		t.Fatal("expected Write to fail once capacity is exhausted")
+	}
+}